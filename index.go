@@ -0,0 +1,103 @@
+package search
+
+import (
+	"cmp"
+	"math/bits"
+	"unsafe"
+)
+
+// prefetchDist is how many elements ahead (in Eytzinger-index space) Lookup
+// prefetches. 16 matches a cache line's worth of the descendants a few
+// levels below the current node, which is roughly where the next iteration
+// of the loop is going to land.
+const prefetchDist = 16
+
+// Index is a search structure built once from a sorted slice and then
+// queried many times. Unlike SearchOrdered, which operates directly on the
+// caller's slice, Index stores its own copy of the data in Eytzinger (BFS /
+// implicit binary heap) order: the layout a balanced binary search would
+// visit is linearized so that sequential levels of the search sit close
+// together in memory, which is friendlier to the cache than the wide jumps
+// a plain binary search makes into a sorted slice. This amortizes the
+// reordering cost across the many lookups that justify building an Index in
+// the first place.
+type Index[T cmp.Ordered] struct {
+	eyt []T   // eyt[1:n+1] holds the data in Eytzinger order; eyt[0] is unused
+	pos []int // pos[k] is the index into the original slice that eyt[k] came from
+}
+
+// NewIndex builds an Index over src, which must already be sorted in
+// ascending order. The Index keeps its own copy of the data; src is not
+// retained.
+func NewIndex[T cmp.Ordered](src []T) *Index[T] {
+	n := len(src)
+	idx := &Index[T]{
+		eyt: make([]T, n+1),
+		pos: make([]int, n+1),
+	}
+	i := 0
+	idx.build(src, 1, &i)
+	return idx
+}
+
+// build lays src out in Eytzinger order starting at node k: recurse into
+// the left subtree, place the next unvisited (in ascending order) source
+// element at k, then recurse into the right subtree. Because the recursion
+// always visits the left subtree before consuming an element, this in-order
+// walk consumes src[0], src[1], ... in order, which is exactly the sequence
+// a balanced BST built over the sorted src would assign to a BFS layout.
+func (idx *Index[T]) build(src []T, k int, i *int) {
+	if k > len(src) {
+		return
+	}
+	idx.build(src, 2*k, i)
+	idx.eyt[k] = src[*i]
+	idx.pos[k] = *i
+	*i++
+	idx.build(src, 2*k+1, i)
+}
+
+// Lookup returns the index in the original slice of the smallest element
+// that is >= needle, or len(src) if no such element exists.
+func (idx *Index[T]) Lookup(needle T) int {
+	return idx.search(needle, false)
+}
+
+// LookupRange returns [lo, hi) such that the original slice's lo:hi span is
+// exactly the elements in [low, high]. If no elements fall in that range,
+// lo == hi.
+func (idx *Index[T]) LookupRange(low, high T) (int, int) {
+	return idx.search(low, false), idx.search(high, true)
+}
+
+// search walks the Eytzinger array for the boundary between "before needle"
+// and "at-or-after needle" (strict controls whether needle itself counts as
+// "before"), then recovers the answer position with a bit trick: the walk
+// runs off the bottom of the tree, and its last "go left" (2k) step is
+// followed only by "go right" (2k+1) steps, which are the trailing one bits
+// of k; shifting off those bits and the zero bit of the left step before
+// them walks back up to the last node where the walk went left, which is
+// the answer (k becomes 0 if the walk never went left).
+func (idx *Index[T]) search(needle T, strict bool) int {
+	n := len(idx.eyt) - 1
+	k := 1
+	for k <= n {
+		if next := k * prefetchDist; next <= n {
+			prefetch(unsafe.Pointer(&idx.eyt[next]))
+		}
+		before := idx.eyt[k] < needle
+		if strict {
+			before = idx.eyt[k] <= needle
+		}
+		if before {
+			k = 2*k + 1
+		} else {
+			k = 2 * k
+		}
+	}
+	k >>= bits.TrailingZeros(uint(^k)) + 1
+	if k == 0 {
+		return n
+	}
+	return idx.pos[k]
+}
@@ -0,0 +1,78 @@
+package search
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestIndexLookup(t *testing.T) {
+	src := []int{1, 3, 3, 5, 7, 9, 11}
+	idx := NewIndex(src)
+
+	tests := []struct {
+		needle int
+		want   int
+	}{
+		{0, 0},
+		{1, 0},
+		{2, 1},
+		{3, 1},
+		{4, 3},
+		{11, 6},
+		{12, 7},
+	}
+	for _, tt := range tests {
+		if got := idx.Lookup(tt.needle); got != tt.want {
+			t.Errorf("Lookup(%d) = %d, want %d", tt.needle, got, tt.want)
+		}
+	}
+}
+
+func TestIndexLookupAgreesWithSort(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 7, 8, 9, 63, 64, 65, 200} {
+		src := generateSortedFloat64s(n)
+		idx := NewIndex(src)
+		for _, needle := range []float64{-1, 0, float64(n / 2), float64(n), float64(n) + 0.5} {
+			if got, want := idx.Lookup(needle), sort.SearchFloat64s(src, needle); got != want {
+				t.Errorf("n=%d needle=%v: Index.Lookup=%d sort.SearchFloat64s=%d", n, needle, got, want)
+			}
+		}
+	}
+}
+
+func TestIndexLookupRange(t *testing.T) {
+	src := []int{1, 2, 4, 4, 4, 7, 9}
+	idx := NewIndex(src)
+
+	tests := []struct {
+		low, high int
+		lo, hi    int
+	}{
+		{4, 4, 2, 5},
+		{3, 8, 2, 6},
+		{-10, 0, 0, 0},
+		{10, 20, 7, 7},
+		{-10, 20, 0, 7},
+	}
+	for _, tt := range tests {
+		lo, hi := idx.LookupRange(tt.low, tt.high)
+		if lo != tt.lo || hi != tt.hi {
+			t.Errorf("LookupRange(%d, %d) = (%d, %d), want (%d, %d)", tt.low, tt.high, lo, hi, tt.lo, tt.hi)
+		}
+	}
+}
+
+func BenchmarkIndexLookupRandom(b *testing.B) {
+	length := haystackLen
+	haystack := generateRandomSortedFloat64s(length)
+	mean := (slices.Max(haystack) + slices.Min(haystack)) / 2
+	idx := NewIndex(haystack)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		needle := rand.NormFloat64() + mean
+		resultFindBucket = idx.Lookup(needle)
+	}
+}
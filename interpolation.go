@@ -0,0 +1,106 @@
+package search
+
+// InterpolationSearchFloat64s returns the same insertion-point result as
+// sort.SearchFloat64s: the smallest index i such that haystack[i] >= needle,
+// or len(haystack) if there is no such index. haystack must be sorted in
+// ascending order.
+//
+// Rather than halving the search interval, it first estimates where needle
+// should fall by linear interpolation between the interval's endpoints,
+// which on near-uniformly distributed data (such as the normally-distributed
+// haystacks generated by generateRandomSortedFloat64s) narrows the interval
+// faster than halving does. It makes at most three such guesses: a guess
+// that lands outside [lo, hi] counts as one of them without narrowing the
+// interval, and whatever interval is left after the third guess is finished
+// with plain binary search, so the worst case stays O(log n).
+func InterpolationSearchFloat64s(haystack []float64, needle float64) int {
+	lo, hi := 0, len(haystack)-1
+	badGuesses := 0
+
+	for lo <= hi && badGuesses < 3 {
+		if needle <= haystack[lo] {
+			return lo
+		}
+		if needle > haystack[hi] {
+			return hi + 1
+		}
+
+		span := haystack[hi] - haystack[lo]
+		if span == 0 {
+			break
+		}
+		mid := lo + int(float64(hi-lo)*(needle-haystack[lo])/span)
+		if mid < lo || mid > hi {
+			badGuesses++
+			continue
+		}
+
+		switch {
+		case haystack[mid] == needle:
+			for mid > lo && haystack[mid-1] == needle {
+				mid--
+			}
+			return mid
+		case !(haystack[mid] >= needle):
+			// Negated for the same NaN reason as binarySearchFloat64sRange.
+			lo = mid + 1
+		default:
+			hi = mid - 1
+		}
+		badGuesses++
+	}
+
+	return binarySearchFloat64sRange(haystack, needle, lo, hi)
+}
+
+// binarySearchFloat64sRange is a plain binary search restricted to
+// haystack[lo:hi+1], used as the worst-case fallback for the search
+// variants in this file that otherwise rely on data-dependent guesses.
+func binarySearchFloat64sRange(haystack []float64, needle float64, lo, hi int) int {
+	for lo <= hi {
+		mid := int(uint(lo+hi) >> 1)
+		// Written as !(haystack[mid] >= needle) rather than haystack[mid] <
+		// needle so that a NaN needle, which compares false against
+		// everything, converges to len(haystack) like sort.SearchFloat64s
+		// does, instead of staying put at the initial lo.
+		if !(haystack[mid] >= needle) {
+			lo = mid + 1
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo
+}
+
+// ExponentialSearchFloat64s returns the same insertion-point result as
+// sort.SearchFloat64s: the smallest index i such that haystack[i] >= needle,
+// or len(haystack) if there is no such index. haystack must be sorted in
+// ascending order.
+//
+// It first finds a bound on needle's position by doubling (1, 2, 4, ...)
+// until haystack[bound] >= needle, then binary-searches the resulting
+// [bound/2, bound] window. This makes the cost grow with the logarithm of
+// needle's position in haystack rather than of len(haystack), which is an
+// improvement whenever needle is expected to be near the
+// beginning, as in the "beginning" case of BenchmarkSearchFunctions.
+func ExponentialSearchFloat64s(haystack []float64, needle float64) int {
+	n := len(haystack)
+	if n == 0 || haystack[0] >= needle {
+		return 0
+	}
+
+	bound := 1
+	// !(haystack[bound] >= needle) rather than haystack[bound] < needle so a
+	// NaN needle keeps doubling to the end of haystack instead of stopping
+	// at bound=1, same reasoning as binarySearchFloat64sRange.
+	for bound < n && !(haystack[bound] >= needle) {
+		bound *= 2
+	}
+
+	lo := bound / 2
+	hi := bound
+	if hi >= n {
+		hi = n - 1
+	}
+	return binarySearchFloat64sRange(haystack, needle, lo, hi)
+}
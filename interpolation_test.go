@@ -0,0 +1,73 @@
+package search
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+)
+
+func TestInterpolationSearchFloat64s(t *testing.T) {
+	tests := []struct {
+		name     string
+		haystack []float64
+		needle   float64
+		want     int
+	}{
+		{"empty", nil, 1.0, 0},
+		{"single_found", []float64{1.0}, 1.0, 0},
+		{"single_not_found", []float64{1.0}, 2.0, 1},
+		{"duplicates_leftmost", []float64{2, 2, 2, 2, 2}, 2, 0},
+		{"duplicates_middle", []float64{1, 2, 2, 2, 3}, 2, 1},
+		{"too_low", []float64{1, 2, 3, 4, 5}, -1, 0},
+		{"too_high", []float64{1, 2, 3, 4, 5}, 500_000_000.0, 5},
+		{"nan_needle_never_found", []float64{1, 2, 3, 4, 5}, math.NaN(), 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InterpolationSearchFloat64s(tt.haystack, tt.needle); got != tt.want {
+				t.Errorf("InterpolationSearchFloat64s(%v, %v) = %d, want %d", tt.haystack, tt.needle, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExponentialSearchFloat64s(t *testing.T) {
+	tests := []struct {
+		name     string
+		haystack []float64
+		needle   float64
+		want     int
+	}{
+		{"empty", nil, 1.0, 0},
+		{"single_found", []float64{1.0}, 1.0, 0},
+		{"single_not_found", []float64{1.0}, 2.0, 1},
+		{"duplicates_leftmost", []float64{2, 2, 2, 2, 2}, 2, 0},
+		{"duplicates_middle", []float64{1, 2, 2, 2, 3}, 2, 1},
+		{"too_low", []float64{1, 2, 3, 4, 5}, -1, 0},
+		{"too_high", []float64{1, 2, 3, 4, 5}, 500_000_000.0, 5},
+		{"nan_needle_never_found", []float64{1, 2, 3, 4, 5}, math.NaN(), 5},
+		{"beginning", []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 2, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExponentialSearchFloat64s(tt.haystack, tt.needle); got != tt.want {
+				t.Errorf("ExponentialSearchFloat64s(%v, %v) = %d, want %d", tt.haystack, tt.needle, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterpolationAndExponentialAgreeWithSort(t *testing.T) {
+	haystack := generateRandomSortedFloat64s(500)
+	for i := 0; i < 200; i++ {
+		needle := rand.NormFloat64()*20 + 50.1
+		want := sort.SearchFloat64s(haystack, needle)
+		if got := InterpolationSearchFloat64s(haystack, needle); got != want {
+			t.Errorf("InterpolationSearchFloat64s(needle=%v) = %d, want %d", needle, got, want)
+		}
+		if got := ExponentialSearchFloat64s(haystack, needle); got != want {
+			t.Errorf("ExponentialSearchFloat64s(needle=%v) = %d, want %d", needle, got, want)
+		}
+	}
+}
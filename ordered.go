@@ -0,0 +1,134 @@
+package search
+
+import "cmp"
+
+// Crossover lengths at or below which SearchOrdered uses a linear scan
+// instead of a binary search. float64Crossover is a few dozen elements, the
+// size up to which branch mispredicts in the binary search cost more than
+// the linear scan's extra comparisons. intCrossover and stringCrossover are
+// set relative to it: strings carry a higher per-comparison cost, so their
+// crossover sits lower, while integers compare even more cheaply than
+// floats, so theirs sits a bit higher. BenchmarkSearchOrdered and its
+// Ints/Strings variants time SearchOrdered at lengths on both sides of each
+// value; they do not time linearSearchOrdered and
+// branchlessBinarySearchOrdered against each other at the same length.
+const (
+	float64Crossover = 64
+	intCrossover     = 96
+	stringCrossover  = 24
+	defaultCrossover = 64
+)
+
+// SearchOrdered returns the smallest index i such that haystack[i] >= needle,
+// or len(haystack) if there is no such index. haystack must be sorted in
+// ascending order.
+//
+// It picks between a linear scan and a branchless binary search based on
+// len(haystack), since the two have opposite strengths: linear scans win on
+// small slices (see BenchmarkSearchOrdered), while binary search wins once
+// the O(log n) comparisons it makes are cheaper than the O(n) the linear
+// scan would need.
+func SearchOrdered[T cmp.Ordered](haystack []T, needle T) int {
+	if len(haystack) <= crossoverFor(needle) {
+		return linearSearchOrdered(haystack, needle)
+	}
+	return branchlessBinarySearchOrdered(haystack, needle)
+}
+
+// crossoverFor returns the length at or below which linearSearchOrdered should be
+// preferred over branchlessBinarySearchOrdered for T.
+func crossoverFor[T cmp.Ordered](needle T) int {
+	switch any(needle).(type) {
+	case float32, float64:
+		return float64Crossover
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr:
+		return intCrossover
+	case string:
+		return stringCrossover
+	default:
+		return defaultCrossover
+	}
+}
+
+// linearSearchOrdered is unrolled the same way linearSearchFloat64sGeneric
+// is, for the same reason: checking several elements per loop iteration
+// gives the compiler more independent comparisons to overlap instead of
+// stalling on one branch at a time.
+func linearSearchOrdered[T cmp.Ordered](haystack []T, needle T) int {
+	n := len(haystack)
+	i := 0
+
+	for n >= 4 {
+		if haystack[i] >= needle {
+			return i
+		}
+		if haystack[i+1] >= needle {
+			return i + 1
+		}
+		if haystack[i+2] >= needle {
+			return i + 2
+		}
+		if haystack[i+3] >= needle {
+			return i + 3
+		}
+		i += 4
+		n -= 4
+	}
+
+	for n > 0 {
+		if haystack[i] >= needle {
+			return i
+		}
+		i++
+		n--
+	}
+	return len(haystack)
+}
+
+// branchlessBinarySearchOrdered finds the smallest index i such that
+// haystack[i] >= needle using the Shar/Eytzinger-style branchless form: base
+// and n shrink monotonically and the update to base is written as a
+// conditional move rather than a branch, avoiding the mispredict cost a
+// regular binary search pays on unpredictable queries.
+//
+// The comparison is written as !(haystack[k] >= needle) rather than
+// haystack[k] < needle: for ordinary values the two are equivalent, but they
+// diverge when needle is NaN, and we want the same "never found" result
+// (len(haystack)) that the negated form produces, matching sort.Search's
+// convention.
+func branchlessBinarySearchOrdered[T cmp.Ordered](haystack []T, needle T) int {
+	n := len(haystack)
+	base := 0
+	for n > 1 {
+		half := n / 2
+		if !(haystack[base+half-1] >= needle) {
+			base += half
+		}
+		n -= half
+	}
+	if n == 1 && !(haystack[base] >= needle) {
+		base++
+	}
+	return base
+}
+
+// SearchInts returns the smallest index i such that haystack[i] >= needle,
+// or len(haystack) if there is no such index. haystack must be sorted in
+// ascending order.
+func SearchInts(haystack []int, needle int) int {
+	return SearchOrdered(haystack, needle)
+}
+
+// SearchStrings returns the smallest index i such that haystack[i] >= needle,
+// or len(haystack) if there is no such index. haystack must be sorted in
+// ascending order.
+func SearchStrings(haystack []string, needle string) int {
+	return SearchOrdered(haystack, needle)
+}
+
+// SearchFloat64s returns the smallest index i such that haystack[i] >= needle,
+// or len(haystack) if there is no such index. haystack must be sorted in
+// ascending order.
+func SearchFloat64s(haystack []float64, needle float64) int {
+	return SearchOrdered(haystack, needle)
+}
@@ -0,0 +1,73 @@
+package search
+
+import "sort"
+
+// ParallelSearchFloat64s splits haystack into workers contiguous buckets,
+// uses a binary search over the bucket boundaries to find the single bucket
+// that could contain needle, then runs LinearSearchFloat64s on just that bucket.
+// It returns the index of needle in haystack, or len(haystack) if needle is
+// not present. haystack must be sorted in ascending order.
+//
+// This is unlike BenchmarkParallelSearches' naive goroutine fan-out, which
+// runs the same search in every worker: since haystack is sorted, its
+// buckets are disjoint ranges, so only one of them can possibly contain
+// needle. There's no work to spread across goroutines for a single query —
+// just a boundary lookup followed by one bucket-sized scan. Use Partitioned
+// instead when searching the same haystack repeatedly, so the boundary
+// array is built once rather than on every call.
+func ParallelSearchFloat64s(haystack []float64, needle float64, workers int) int {
+	if workers < 1 {
+		workers = 1
+	}
+	bucketSize := (len(haystack) + workers - 1) / workers
+	if bucketSize == 0 {
+		return len(haystack)
+	}
+	return NewPartitioned(haystack, bucketSize).Search(needle)
+}
+
+// Partitioned is haystack's bucket boundaries, precomputed once so that
+// repeated Search calls skip straight to picking a bucket instead of paying
+// ParallelSearchFloat64s' per-call setup cost.
+type Partitioned struct {
+	haystack   []float64
+	bucketSize int
+	boundaries []float64 // boundaries[k] is the largest value in bucket k
+}
+
+// NewPartitioned builds a Partitioned over haystack, which must be sorted
+// in ascending order, using buckets of bucketSize contiguous elements each.
+// It keeps a reference to haystack rather than copying it.
+func NewPartitioned(haystack []float64, bucketSize int) *Partitioned {
+	if bucketSize < 1 {
+		bucketSize = 1
+	}
+	p := &Partitioned{haystack: haystack, bucketSize: bucketSize}
+	for i := bucketSize - 1; i < len(haystack); i += bucketSize {
+		p.boundaries = append(p.boundaries, haystack[i])
+	}
+	return p
+}
+
+// Search returns the index of needle in the haystack Partitioned was built
+// from, or len(haystack) if needle is not present.
+func (p *Partitioned) Search(needle float64) int {
+	if len(p.haystack) == 0 {
+		return 0
+	}
+
+	bucket := sort.SearchFloat64s(p.boundaries, needle)
+	start := bucket * p.bucketSize
+	if start >= len(p.haystack) {
+		return len(p.haystack)
+	}
+	end := start + p.bucketSize
+	if end > len(p.haystack) {
+		end = len(p.haystack)
+	}
+
+	if got := LinearSearchFloat64s(p.haystack[start:end], needle); got < end-start {
+		return start + got
+	}
+	return len(p.haystack)
+}
@@ -0,0 +1,106 @@
+package search
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestParallelSearchFloat64s(t *testing.T) {
+	haystack := []float64{1, 2, 3, 3, 3, 5, 7, 9, 11, 13}
+
+	tests := []struct {
+		needle  float64
+		workers int
+		want    int
+	}{
+		{3, 1, 2},
+		{3, 2, 2},
+		{3, 4, 2},
+		{0, 3, len(haystack)},
+		{20, 3, len(haystack)},
+		{4, 3, len(haystack)}, // between elements: not an exact match
+	}
+	for _, tt := range tests {
+		if got := ParallelSearchFloat64s(haystack, tt.needle, tt.workers); got != tt.want {
+			t.Errorf("ParallelSearchFloat64s(needle=%v, workers=%d) = %d, want %d", tt.needle, tt.workers, got, tt.want)
+		}
+	}
+}
+
+func TestParallelSearchFloat64sAgreesWithLinear(t *testing.T) {
+	haystack := generateSortedFloat64s(137)
+	for _, workers := range []int{1, 2, 3, 5, 16} {
+		for _, needle := range []float64{-1, 0, 50, 68, 68.5, 136, 200} {
+			got := ParallelSearchFloat64s(haystack, needle, workers)
+			want := linearSearchFloat64sGeneric(haystack, needle)
+			if got != want {
+				t.Errorf("workers=%d needle=%v: ParallelSearchFloat64s=%d linearSearchFloat64sGeneric=%d", workers, needle, got, want)
+			}
+		}
+	}
+}
+
+func TestPartitionedSearch(t *testing.T) {
+	haystack := generateSortedFloat64s(50)
+	for _, bucketSize := range []int{1, 3, 7, 50, 1000} {
+		p := NewPartitioned(haystack, bucketSize)
+		for _, needle := range []float64{-1, 0, 25, 25.5, 49, 100} {
+			got := p.Search(needle)
+			want := linearSearchFloat64sGeneric(haystack, needle)
+			if got != want {
+				t.Errorf("bucketSize=%d needle=%v: Partitioned.Search=%d linearSearchFloat64sGeneric=%d", bucketSize, needle, got, want)
+			}
+		}
+	}
+}
+
+func TestPartitionedSearchEmpty(t *testing.T) {
+	p := NewPartitioned(nil, 8)
+	if got := p.Search(1.0); got != 0 {
+		t.Errorf("Search on empty Partitioned = %d, want 0", got)
+	}
+}
+
+func BenchmarkPartitionedVsBinary(b *testing.B) {
+	lengths := []int{10_000, 100_000, 1_000_000}
+	const bucketSize = 64
+
+	for _, n := range lengths {
+		haystack := generateRandomSortedFloat64s(n)
+		mean := (haystack[0] + haystack[n-1]) / 2
+		p := NewPartitioned(haystack, bucketSize)
+
+		b.Run(fmt.Sprintf("Partitioned/n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				needle := rand.NormFloat64() + mean
+				resultFindBucket = p.Search(needle)
+			}
+		})
+
+		b.Run(fmt.Sprintf("BinarySearch/n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				needle := rand.NormFloat64() + mean
+				resultFindBucket = sort.SearchFloat64s(haystack, needle)
+			}
+		})
+
+		b.Run(fmt.Sprintf("NaiveGoroutineFanOut/n=%d", n), func(b *testing.B) {
+			const workers = 8
+			for i := 0; i < b.N; i++ {
+				needle := rand.NormFloat64() + mean
+				var wg sync.WaitGroup
+				wg.Add(workers)
+				for w := 0; w < workers; w++ {
+					go func() {
+						defer wg.Done()
+						resultFindBucket = sort.SearchFloat64s(haystack, needle)
+					}()
+				}
+				wg.Wait()
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+//go:build amd64 || arm64
+
+package search
+
+import "unsafe"
+
+// prefetch issues a hardware prefetch hint for addr. It has no observable
+// effect beyond timing.
+//
+//go:noescape
+func prefetch(addr unsafe.Pointer)
@@ -0,0 +1,8 @@
+//go:build !amd64 && !arm64
+
+package search
+
+import "unsafe"
+
+// prefetch is a no-op on architectures without an assembly prefetch helper.
+func prefetch(addr unsafe.Pointer) {}
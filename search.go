@@ -1,6 +1,25 @@
 package search
 
+// LinearSearchFloat64s returns the index of needle in haystack, or
+// len(haystack) if needle is not present. haystack must be sorted in
+// ascending order: the search stops as soon as it passes the point where
+// needle would belong, since a sorted haystack can't contain it past there.
+//
+// On amd64 with AVX2 and haystacks long enough to amortize the dispatch, it
+// widens the per-iteration work with SIMD comparisons instead of falling
+// back to the unrolled scalar scan below; see simd_amd64.go. Other
+// architectures always use the scalar scan; see simd_other.go.
 func LinearSearchFloat64s(haystack []float64, needle float64) int {
+	if simdAvailable && len(haystack) >= simdMinLen {
+		return linearSearchFloat64sSIMD(haystack, needle)
+	}
+	return linearSearchFloat64sGeneric(haystack, needle)
+}
+
+// linearSearchFloat64sGeneric is the portable, unrolled scalar fallback used
+// when no vectorized kernel is available or the haystack is too short for
+// one to pay off.
+func linearSearchFloat64sGeneric(haystack []float64, needle float64) int {
 	n := len(haystack)
 	i := 0
 
@@ -2,6 +2,7 @@ package search
 
 import (
 	"fmt"
+	"math"
 	"math/rand/v2"
 	"slices"
 	"sort"
@@ -115,6 +116,18 @@ func BenchmarkSearchFunctions(b *testing.B) {
 					result = sort.SearchFloat64s(haystack, needle)
 				}
 			})
+
+			b.Run(fmt.Sprintf("Interpolation/n=%d/pos=%s", n, pos), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					result = InterpolationSearchFloat64s(haystack, needle)
+				}
+			})
+
+			b.Run(fmt.Sprintf("Exponential/n=%d/pos=%s", n, pos), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					result = ExponentialSearchFloat64s(haystack, needle)
+				}
+			})
 		}
 	}
 }
@@ -232,6 +245,250 @@ func BenchmarkLinearSearchImplementations(b *testing.B) {
 					result = sort.SearchFloat64s(haystack, needle)
 				}
 			})
+
+			b.Run(fmt.Sprintf("Interpolation/n=%d/pos=%s", n, pos), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					result = InterpolationSearchFloat64s(haystack, needle)
+				}
+			})
+
+			b.Run(fmt.Sprintf("Exponential/n=%d/pos=%s", n, pos), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					result = ExponentialSearchFloat64s(haystack, needle)
+				}
+			})
+		}
+	}
+}
+
+func TestSearchFloat64s(t *testing.T) {
+	tests := []struct {
+		name     string
+		haystack []float64
+		needle   float64
+		want     int
+	}{
+		{"empty", nil, 1.0, 0},
+		{"single_found", []float64{1.0}, 1.0, 0},
+		{"single_not_found", []float64{1.0}, 2.0, 1},
+		{"duplicates_leftmost", []float64{2, 2, 2, 2, 2}, 2, 0},
+		{"duplicates_middle", []float64{1, 2, 2, 2, 3}, 2, 1},
+		{"negative_zero_matches_zero", []float64{math.Copysign(0, -1), 1, 2}, 0.0, 0},
+		{"positive_zero_matches_negative_zero", []float64{0.0, 1, 2}, math.Copysign(0, -1), 0},
+		{"too_low", []float64{1, 2, 3}, -1, 0},
+		{"too_high", []float64{1, 2, 3}, 500_000_000.0, 3},
+		{"nan_needle_never_found", []float64{1, 2, 3}, math.NaN(), 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SearchFloat64s(tt.haystack, tt.needle); got != tt.want {
+				t.Errorf("SearchFloat64s(%v, %v) = %d, want %d", tt.haystack, tt.needle, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchOrderedCrossoverAgreement(t *testing.T) {
+	// The linear and branchless-binary strategies must agree regardless of
+	// which side of the crossover length they fall on.
+	for _, n := range []int{0, 1, float64Crossover - 1, float64Crossover, float64Crossover + 1, float64Crossover * 4} {
+		haystack := generateSortedFloat64s(n)
+		for _, needle := range []float64{-1, 0, float64(n / 2), float64(n), math.NaN()} {
+			got := SearchOrdered(haystack, needle)
+			want := linearSearchOrdered(haystack, needle)
+			if got != want {
+				t.Errorf("n=%d needle=%v: SearchOrdered=%d linearSearchOrdered=%d", n, needle, got, want)
+			}
+		}
+	}
+}
+
+func TestSearchInts(t *testing.T) {
+	haystack := []int{1, 3, 3, 5, 7, 9}
+	tests := []struct {
+		needle int
+		want   int
+	}{
+		{0, 0},
+		{3, 1},
+		{4, 3},
+		{9, 5},
+		{10, 6},
+	}
+	for _, tt := range tests {
+		if got := SearchInts(haystack, tt.needle); got != tt.want {
+			t.Errorf("SearchInts(%v, %d) = %d, want %d", haystack, tt.needle, got, tt.want)
+		}
+	}
+}
+
+func TestSearchStrings(t *testing.T) {
+	haystack := []string{"a", "b", "b", "d", "f"}
+	tests := []struct {
+		needle string
+		want   int
+	}{
+		{"", 0},
+		{"b", 1},
+		{"c", 3},
+		{"g", 5},
+	}
+	for _, tt := range tests {
+		if got := SearchStrings(haystack, tt.needle); got != tt.want {
+			t.Errorf("SearchStrings(%v, %q) = %d, want %d", haystack, tt.needle, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkSearchOrdered(b *testing.B) {
+	lengths := []int{10, 20, 30, 35, 40, 50, 60, 100}
+	positions := []string{"beginning", "middle", "end", "too_low", "too_high"}
+
+	for _, n := range lengths {
+		haystack := generateSortedFloat64s(n)
+		for _, pos := range positions {
+			var needle float64
+			switch pos {
+			case "beginning":
+				index := n / 10
+				needle = haystack[index]
+			case "middle":
+				needle = haystack[n/2]
+			case "end":
+				index := n - n/10 - 1
+				if index < 0 {
+					index = n - 1
+				}
+				needle = haystack[index]
+			case "too_low":
+				needle = -1.0
+			case "too_high":
+				needle = 500_000_000.0
+			}
+
+			b.Run(fmt.Sprintf("SearchOrdered/n=%d/pos=%s", n, pos), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					result = SearchOrdered(haystack, needle)
+				}
+			})
+		}
+	}
+}
+
+func generateSortedInts(n int) []int {
+	haystack := make([]int, n)
+	for i := range haystack {
+		haystack[i] = i
+	}
+	return haystack
+}
+
+func generateSortedStrings(n int) []string {
+	haystack := make([]string, n)
+	for i := range haystack {
+		haystack[i] = fmt.Sprintf("%08d", i)
+	}
+	return haystack
+}
+
+func BenchmarkSearchOrderedInts(b *testing.B) {
+	lengths := []int{10, 20, 30, 50, 75, 96, 100, 150}
+	positions := []string{"beginning", "middle", "end", "too_low", "too_high"}
+
+	for _, n := range lengths {
+		haystack := generateSortedInts(n)
+		for _, pos := range positions {
+			var needle int
+			switch pos {
+			case "beginning":
+				needle = haystack[n/10]
+			case "middle":
+				needle = haystack[n/2]
+			case "end":
+				index := n - n/10 - 1
+				if index < 0 {
+					index = n - 1
+				}
+				needle = haystack[index]
+			case "too_low":
+				needle = -1
+			case "too_high":
+				needle = 500_000_000
+			}
+
+			b.Run(fmt.Sprintf("SearchOrderedInts/n=%d/pos=%s", n, pos), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					result = SearchOrdered(haystack, needle)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkSearchOrderedStrings(b *testing.B) {
+	lengths := []int{5, 10, 15, 20, 24, 30, 40, 60}
+	positions := []string{"beginning", "middle", "end", "too_low", "too_high"}
+
+	for _, n := range lengths {
+		haystack := generateSortedStrings(n)
+		for _, pos := range positions {
+			var needle string
+			switch pos {
+			case "beginning":
+				needle = haystack[n/10]
+			case "middle":
+				needle = haystack[n/2]
+			case "end":
+				index := n - n/10 - 1
+				if index < 0 {
+					index = n - 1
+				}
+				needle = haystack[index]
+			case "too_low":
+				needle = ""
+			case "too_high":
+				needle = "~~~~~~~~"
+			}
+
+			b.Run(fmt.Sprintf("SearchOrderedStrings/n=%d/pos=%s", n, pos), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					result = SearchOrdered(haystack, needle)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkSearchFloat64sSIMD(b *testing.B) {
+	lengths := []int{8, 16, 64, 256, 1024, 4096}
+	positions := []string{"beginning", "middle", "end", "too_low", "too_high"}
+
+	for _, n := range lengths {
+		haystack := generateSortedFloat64s(n)
+		for _, pos := range positions {
+			var needle float64
+			switch pos {
+			case "beginning":
+				needle = haystack[n/10]
+			case "middle":
+				needle = haystack[n/2]
+			case "end":
+				index := n - n/10 - 1
+				if index < 0 {
+					index = n - 1
+				}
+				needle = haystack[index]
+			case "too_low":
+				needle = -1.0
+			case "too_high":
+				needle = 500_000_000.0
+			}
+
+			b.Run(fmt.Sprintf("SIMD/n=%d/pos=%s", n, pos), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					result = LinearSearchFloat64s(haystack, needle)
+				}
+			})
 		}
 	}
 }
@@ -0,0 +1,20 @@
+//go:build amd64
+
+package search
+
+import "golang.org/x/sys/cpu"
+
+// simdMinLen is the shortest haystack for which the SIMD kernel's per-call
+// overhead (broadcasting needle into a vector register, etc.) is worth
+// paying instead of just running the scalar scan.
+const simdMinLen = 8
+
+var simdAvailable = cpu.X86.HasAVX2
+
+// linearSearchFloat64sSIMD is implemented in search_amd64.s. It compares 8
+// elements per iteration (two YMM registers of 4 float64 lanes each)
+// against needle broadcast once into a YMM register, branching only on the
+// OR of the two lanes' VMOVMSKPD results.
+//
+//go:noescape
+func linearSearchFloat64sSIMD(haystack []float64, needle float64) int
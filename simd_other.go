@@ -0,0 +1,19 @@
+//go:build !amd64
+
+package search
+
+const simdMinLen = 0
+
+var simdAvailable = false
+
+// linearSearchFloat64sSIMD is never called on architectures without a
+// vectorized kernel (simdAvailable is always false), but it needs a body to
+// satisfy LinearSearchFloat64s' dispatch.
+//
+// arm64 falls back to this generic path rather than a NEON kernel: Go's
+// assembler has no floating-point NEON compare instruction (only VCMEQ /
+// VCMTST for integer vector compares), so there's no way to express the
+// needed >= comparison directly in arm64 assembly.
+func linearSearchFloat64sSIMD(haystack []float64, needle float64) int {
+	return linearSearchFloat64sGeneric(haystack, needle)
+}
@@ -0,0 +1,23 @@
+package search
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLinearSearchFloat64sAcrossLengths(t *testing.T) {
+	// Exercise every remainder mod the amd64 SIMD kernel's vector width (8)
+	// so both the vectorized and scalar-tail code paths get covered there;
+	// on other architectures this just exercises the generic path twice.
+	for n := 0; n <= 20; n++ {
+		haystack := generateSortedFloat64s(n)
+		needles := []float64{-1, 0, float64(n / 2), float64(n), float64(n) + 0.5, math.NaN()}
+		for _, needle := range needles {
+			got := LinearSearchFloat64s(haystack, needle)
+			want := linearSearchFloat64sGeneric(haystack, needle)
+			if got != want {
+				t.Errorf("n=%d needle=%v: LinearSearchFloat64s=%d generic=%d", n, needle, got, want)
+			}
+		}
+	}
+}